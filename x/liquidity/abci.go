@@ -35,4 +35,12 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 	k.ExecutePoolBatch(ctx)
+
+	// Settle any MsgCreatePosition/MsgWithdrawPosition queued against a concentrated pool this
+	// block, after its swap batch (if any) has cleared.
+	for _, pool := range k.GetAllConcentratedPools(ctx) {
+		if err := k.ExecutePositionMsgs(ctx, pool.Id); err != nil {
+			ctx.Logger().Error("failed to execute position msgs", "pool_id", pool.Id, "error", err)
+		}
+	}
 }
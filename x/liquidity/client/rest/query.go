@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 
@@ -15,6 +16,8 @@ import (
 func registerQueryRoutes(cliCtx client.Context, r *mux.Router) {
 	// query liquidity
 	r.HandleFunc(fmt.Sprintf("/liquidity/pool/{%s}", RestPoolId), queryLiquidityHandlerFn(cliCtx)).Methods("GET")
+	// query a simulated swap outcome
+	r.HandleFunc(fmt.Sprintf("/liquidity/pool/{%s}/simulate_swap", RestPoolId), querySimulateSwapHandlerFn(cliCtx)).Methods("GET")
 }
 
 // HTTP request handler to query liquidity information.
@@ -54,3 +57,59 @@ func queryLiquidityHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to preview a swap order's outcome against a pool's current batch.
+func querySimulateSwapHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strPoolId := vars[RestPoolId]
+
+		poolID, ok := rest.ParseUint64OrReturnBadRequest(w, strPoolId)
+		if !ok {
+			return
+		}
+
+		offerCoinStr := r.URL.Query().Get("offer_coin")
+		offerCoin, err := sdk.ParseCoinNormalized(offerCoinStr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		demandCoinDenom := r.URL.Query().Get("demand_coin_denom")
+
+		orderPrice, err := sdk.NewDecFromStr(r.URL.Query().Get("order_price"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.QuerySimulateSwapRequest{
+			PoolId:          poolID,
+			OfferCoin:       offerCoin,
+			DemandCoinDenom: demandCoinDenom,
+			OrderPrice:      orderPrice,
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySimulateSwap)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,33 @@
+package liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// NewHandler routes the concentrated-liquidity position messages to the keeper.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgCreatePosition:
+			if err := k.EnqueueCreatePositionMsg(ctx, msg); err != nil {
+				return nil, err
+			}
+			return &sdk.Result{Events: ctx.EventManager().Events().ToABCIEvents()}, nil
+
+		case *types.MsgWithdrawPosition:
+			if err := k.EnqueueWithdrawPositionMsg(ctx, msg); err != nil {
+				return nil, err
+			}
+			return &sdk.Result{Events: ctx.EventManager().Events().ToABCIEvents()}, nil
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// vectorsDir is the corpus of frozen swap batch vectors checked against the matching engine.
+// Regenerate it with `make gen-vectors` after an intentional change to the algorithm.
+const vectorsDir = "../types/testdata/vectors"
+
+type vectorOrder struct {
+	Direction         string `json:"direction"`
+	Price             string `json:"price"`
+	OfferAmt          string `json:"offer_amt"`
+	RemainingOfferAmt string `json:"remaining_offer_amt"`
+	Fee               string `json:"fee"`
+}
+
+type vectorMessage struct {
+	TransactedCoinAmt      string `json:"transacted_coin_amt"`
+	ExchangedDemandCoinAmt string `json:"exchanged_demand_coin_amt"`
+	Succeeded              bool   `json:"succeeded"`
+	ToBeDeleted            bool   `json:"to_be_deleted"`
+}
+
+type vectorExpected struct {
+	SwapPrice     string          `json:"swap_price"`
+	MatchType     int             `json:"match_type"`
+	EX            string          `json:"ex"`
+	EY            string          `json:"ey"`
+	PoolXDelta    string          `json:"pool_x_delta"`
+	PoolYDelta    string          `json:"pool_y_delta"`
+	DecimalErrorX string          `json:"decimal_error_x"`
+	DecimalErrorY string          `json:"decimal_error_y"`
+	Messages      []vectorMessage `json:"messages"`
+}
+
+type swapBatchVector struct {
+	Name     string         `json:"name"`
+	ReserveX string         `json:"reserve_x"`
+	ReserveY string         `json:"reserve_y"`
+	DenomX   string         `json:"denom_x"`
+	DenomY   string         `json:"denom_y"`
+	Orders   []vectorOrder  `json:"orders"`
+	Expected vectorExpected `json:"expected"`
+}
+
+// TestConformance replays each vector under testdata/vectors directly against the matching
+// engine, with no Keeper store or sdk.Context in the loop.
+func TestConformance(t *testing.T) {
+	if os.Getenv("SKIP_CONFORMANCE") != "" {
+		t.Skip("SKIP_CONFORMANCE set")
+	}
+
+	entries, err := ioutil.ReadDir(vectorsDir)
+	require.NoError(t, err)
+
+	var k keeper.Keeper
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		entry := entry
+		t.Run(entry.Name(), func(t *testing.T) {
+			bz, err := ioutil.ReadFile(filepath.Join(vectorsDir, entry.Name()))
+			require.NoError(t, err)
+
+			var vector swapBatchVector
+			require.NoError(t, json.Unmarshal(bz, &vector))
+
+			X, err := sdk.NewDecFromStr(vector.ReserveX)
+			require.NoError(t, err)
+			Y, err := sdk.NewDecFromStr(vector.ReserveY)
+			require.NoError(t, err)
+
+			swapMsgStates := make([]*types.SwapMsgState, len(vector.Orders))
+			for i, o := range vector.Orders {
+				swapMsgStates[i] = newVectorSwapMsgState(t, uint64(i+1), o, vector.DenomX, vector.DenomY)
+			}
+
+			orderMap, XtoY, YtoX := types.MakeOrderMap(swapMsgStates, vector.DenomX, vector.DenomY, false)
+			orderBook := orderMap.SortOrderBook()
+			result := orderBook.Match(X, Y)
+
+			require.Equal(t, vector.Expected.MatchType, int(result.MatchType), "match type")
+
+			if result.MatchType == types.NoMatch {
+				for i, msg := range swapMsgStates {
+					require.Equal(t, vector.Expected.Messages[i].Succeeded, msg.Succeeded, "order %d succeeded", i)
+					require.Equal(t, vector.Expected.Messages[i].ToBeDeleted, msg.ToBeDeleted, "order %d to_be_deleted", i)
+				}
+				return
+			}
+
+			requireDecEqual(t, vector.Expected.SwapPrice, result.SwapPrice, "swap price")
+			requireDecEqual(t, vector.Expected.EX, result.EX, "EX")
+			requireDecEqual(t, vector.Expected.EY, result.EY, "EY")
+
+			matchResultXtoY, _, _, _ := types.FindOrderMatch(types.DirectionXtoY, XtoY, result.EX, result.SwapPrice, 0)
+			matchResultYtoX, _, _, _ := types.FindOrderMatch(types.DirectionYtoX, YtoX, result.EY, result.SwapPrice, 0)
+
+			_, _, _, _, poolXdelta, poolYdelta, _, _, decimalErrorX, decimalErrorY :=
+				k.UpdateState(X, Y, XtoY, YtoX, matchResultXtoY, matchResultYtoX)
+
+			requireDecEqual(t, vector.Expected.PoolXDelta, poolXdelta, "pool x delta")
+			requireDecEqual(t, vector.Expected.PoolYDelta, poolYdelta, "pool y delta")
+			requireDecEqual(t, vector.Expected.DecimalErrorX, decimalErrorX, "decimal error x")
+			requireDecEqual(t, vector.Expected.DecimalErrorY, decimalErrorY, "decimal error y")
+
+			matchResultMap := make(map[uint64]types.MatchResult)
+			for _, m := range append(matchResultXtoY, matchResultYtoX...) {
+				matchResultMap[m.BatchMsg.MsgIndex] = m
+			}
+
+			for i, msg := range swapMsgStates {
+				m, matched := matchResultMap[msg.MsgIndex]
+				expected := vector.Expected.Messages[i]
+				require.Equal(t, expected.Succeeded, msg.Succeeded, "order %d succeeded", i)
+				require.Equal(t, expected.ToBeDeleted, msg.ToBeDeleted, "order %d to_be_deleted", i)
+				if matched {
+					requireDecEqual(t, expected.TransactedCoinAmt, m.TransactedCoinAmt, "order %d transacted amount", i)
+					requireDecEqual(t, expected.ExchangedDemandCoinAmt, m.ExchangedDemandCoinAmt, "order %d exchanged demand amount", i)
+				}
+			}
+		})
+	}
+}
+
+func newVectorSwapMsgState(t *testing.T, msgIndex uint64, o vectorOrder, denomX, denomY string) *types.SwapMsgState {
+	price, err := sdk.NewDecFromStr(o.Price)
+	require.NoError(t, err)
+	offerAmt, ok := sdk.NewIntFromString(o.OfferAmt)
+	require.True(t, ok)
+	remainingAmt, ok := sdk.NewIntFromString(o.RemainingOfferAmt)
+	require.True(t, ok)
+	fee, err := sdk.NewDecFromStr(o.Fee)
+	require.NoError(t, err)
+
+	offerDenom, demandDenom := denomX, denomY
+	if o.Direction == "YtoX" {
+		offerDenom, demandDenom = denomY, denomX
+	}
+
+	offerCoin := sdk.NewCoin(offerDenom, offerAmt)
+	msg := types.NewMsgSwapWithinBatch(sdk.AccAddress{}, 1, 1, offerCoin, demandDenom, price, sdk.NewDecFromInt(fee.TruncateInt()))
+
+	msgState := types.NewSwapMsgState(0, msgIndex, msg)
+	msgState.RemainingOfferCoin = sdk.NewCoin(offerDenom, remainingAmt)
+	msgState.ReservedOfferCoinFee = sdk.NewCoin(offerDenom, fee.TruncateInt())
+	msgState.Executed = true
+
+	return msgState
+}
+
+func requireDecEqual(t *testing.T, expected string, actual sdk.Dec, msgAndArgs ...interface{}) {
+	t.Helper()
+	exp, err := sdk.NewDecFromStr(expected)
+	require.NoError(t, err)
+	require.True(t, exp.Equal(actual), append([]interface{}{"expected %s, got %s:", exp, actual}, msgAndArgs...)...)
+}
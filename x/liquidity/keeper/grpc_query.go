@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -27,27 +29,174 @@ func (k Keeper) LiquidityPool(c context.Context, req *types.QueryLiquidityPoolRe
 	return &types.QueryLiquidityPoolResponse{LiquidityPool: pool}, nil
 }
 
-// TODO: after rebase latest stable sdk 0.40.0
 func (k Keeper) LiquidityPools(c context.Context, req *types.QueryLiquidityPoolsRequest) (*types.QueryLiquidityPoolsResponse, error) {
-	return nil, nil
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	poolStore := prefix.NewStore(store, types.LiquidityPoolKeyPrefix)
+
+	var pools []types.LiquidityPool
+	pageRes, err := query.Paginate(poolStore, req.Pagination, func(key []byte, value []byte) error {
+		var pool types.LiquidityPool
+		if err := k.cdc.UnmarshalBinaryBare(value, &pool); err != nil {
+			return err
+		}
+		pools = append(pools, pool)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLiquidityPoolsResponse{LiquidityPools: pools, Pagination: pageRes}, nil
 }
 
 func (k Keeper) LiquidityPoolBatch(c context.Context, req *types.QueryLiquidityPoolBatchRequest) (*types.QueryLiquidityPoolBatchResponse, error) {
-	return nil, nil
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	batch, found := k.GetPoolBatch(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool batch for pool %d doesn't exist", req.PoolId)
+	}
+
+	return &types.QueryLiquidityPoolBatchResponse{Batch: batch}, nil
 }
 
 func (k Keeper) PoolBatchSwapMsgs(c context.Context, req *types.QueryPoolBatchSwapMsgsRequest) (*types.QueryPoolBatchSwapMsgsResponse, error) {
-	return nil, nil
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPoolBatch(ctx, req.PoolId); !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool batch for pool %d doesn't exist", req.PoolId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	msgStore := prefix.NewStore(store, types.GetPoolBatchSwapMsgStatesPrefix(req.PoolId))
+
+	var swapMsgs []types.SwapMsgState
+	pageRes, err := query.FilteredPaginate(msgStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var msgState types.SwapMsgState
+		if err := k.cdc.UnmarshalBinaryBare(value, &msgState); err != nil {
+			return false, err
+		}
+
+		if req.ExecutedOnly && !msgState.Executed {
+			return false, nil
+		}
+		if req.NotExecutedOnly && msgState.Executed {
+			return false, nil
+		}
+		if req.SucceededOnly && !msgState.Succeeded {
+			return false, nil
+		}
+
+		if accumulate {
+			swapMsgs = append(swapMsgs, msgState)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolBatchSwapMsgsResponse{Swaps: swapMsgs, Pagination: pageRes}, nil
 }
 
 func (k Keeper) PoolBatchDepositMsgs(c context.Context, req *types.QueryPoolBatchDepositMsgsRequest) (*types.QueryPoolBatchDepositMsgsResponse, error) {
-	return nil, nil
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPoolBatch(ctx, req.PoolId); !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool batch for pool %d doesn't exist", req.PoolId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	msgStore := prefix.NewStore(store, types.GetPoolBatchDepositMsgStatesPrefix(req.PoolId))
+
+	var depositMsgs []types.DepositMsgState
+	pageRes, err := query.Paginate(msgStore, req.Pagination, func(key []byte, value []byte) error {
+		var msgState types.DepositMsgState
+		if err := k.cdc.UnmarshalBinaryBare(value, &msgState); err != nil {
+			return err
+		}
+		depositMsgs = append(depositMsgs, msgState)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolBatchDepositMsgsResponse{Deposits: depositMsgs, Pagination: pageRes}, nil
 }
 
 func (k Keeper) PoolBatchWithdrawMsgs(c context.Context, req *types.QueryPoolBatchWithdrawMsgsRequest) (*types.QueryPoolBatchWithdrawMsgsResponse, error) {
-	return nil, nil
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPoolBatch(ctx, req.PoolId); !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool batch for pool %d doesn't exist", req.PoolId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	msgStore := prefix.NewStore(store, types.GetPoolBatchWithdrawMsgStatesPrefix(req.PoolId))
+
+	var withdrawMsgs []types.WithdrawMsgState
+	pageRes, err := query.Paginate(msgStore, req.Pagination, func(key []byte, value []byte) error {
+		var msgState types.WithdrawMsgState
+		if err := k.cdc.UnmarshalBinaryBare(value, &msgState); err != nil {
+			return err
+		}
+		withdrawMsgs = append(withdrawMsgs, msgState)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolBatchWithdrawMsgsResponse{Withdraws: withdrawMsgs, Pagination: pageRes}, nil
+}
+
+// SimulateSwap previews the outcome of a hypothetical swap order against a pool's current
+// batch without mutating any state.
+func (k Keeper) SimulateSwap(c context.Context, req *types.QuerySimulateSwapRequest) (*types.QuerySimulateSwapResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp, err := k.simulateSwap(ctx, *req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &resp, nil
 }
 
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
-	return nil, nil
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
 }
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// buildMatchResultMap indexes match results by OrderMsgIndex, returning
+// ErrInvariantDuplicateMatchOrder if any index appears twice so the caller can abort the batch
+// instead of silently overwriting one order's match with another's.
+func buildMatchResultMap(resultSets ...[]types.MatchResult) (map[uint64]types.MatchResult, error) {
+	matchResultMap := make(map[uint64]types.MatchResult)
+	for _, results := range resultSets {
+		for _, msg := range results {
+			if _, ok := matchResultMap[msg.OrderMsgIndex]; ok {
+				return nil, types.ErrInvariantDuplicateMatchOrder
+			}
+			matchResultMap[msg.OrderMsgIndex] = msg
+		}
+	}
+	return matchResultMap, nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// TestBuildMatchResultMapDuplicate guards the invariant that a pathological batch producing the
+// same order twice (e.g. from a msg index collision) aborts instead of letting one match silently
+// clobber another.
+func TestBuildMatchResultMapDuplicate(t *testing.T) {
+	xToY := []types.MatchResult{{OrderMsgIndex: 1}}
+	yToX := []types.MatchResult{{OrderMsgIndex: 1}}
+
+	_, err := buildMatchResultMap(xToY, yToX)
+	require.ErrorIs(t, err, types.ErrInvariantDuplicateMatchOrder)
+}
+
+// TestBuildMatchResultMapDistinct is the non-pathological counterpart: no collision, no error.
+func TestBuildMatchResultMapDistinct(t *testing.T) {
+	xToY := []types.MatchResult{{OrderMsgIndex: 1}, {OrderMsgIndex: 2}}
+	yToX := []types.MatchResult{{OrderMsgIndex: 3}}
+
+	matchResultMap, err := buildMatchResultMap(xToY, yToX)
+	require.NoError(t, err)
+	require.Len(t, matchResultMap, 3)
+}
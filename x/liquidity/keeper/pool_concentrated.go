@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// GetConcentratedPool retrieves a ConcentratedPool (PoolTypeConcentrated) by pool id.
+func (k Keeper) GetConcentratedPool(ctx sdk.Context, poolId uint64) (pool types.ConcentratedPool, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetConcentratedPoolKey(poolId))
+	if bz == nil {
+		return pool, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &pool)
+	return pool, true
+}
+
+// SetConcentratedPool stores a ConcentratedPool, keyed by pool id.
+func (k Keeper) SetConcentratedPool(ctx sdk.Context, pool types.ConcentratedPool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&pool)
+	store.Set(types.GetConcentratedPoolKey(pool.Id), bz)
+}
+
+// GetAllConcentratedPools returns every ConcentratedPool.
+func (k Keeper) GetAllConcentratedPools(ctx sdk.Context) (pools []types.ConcentratedPool) {
+	store := ctx.KVStore(k.storeKey)
+	poolStore := prefix.NewStore(store, types.ConcentratedPoolKeyPrefix)
+
+	iter := poolStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pool types.ConcentratedPool
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &pool)
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// GetPosition retrieves an owner's Position over [lowerTick, upperTick) in a ConcentratedPool.
+func (k Keeper) GetPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int32) (position types.Position, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPositionKey(poolId, owner, lowerTick, upperTick))
+	if bz == nil {
+		return position, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &position)
+	return position, true
+}
+
+// SetPosition stores an owner's Position in a ConcentratedPool.
+func (k Keeper) SetPosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	owner, err := sdk.AccAddressFromBech32(position.Owner)
+	if err != nil {
+		panic(err)
+	}
+	bz := k.cdc.MustMarshalBinaryBare(&position)
+	store.Set(types.GetPositionKey(position.PoolId, owner, position.LowerTick, position.UpperTick), bz)
+}
+
+// GetTickInfo retrieves the TickInfo at tickIndex in a ConcentratedPool.
+func (k Keeper) GetTickInfo(ctx sdk.Context, poolId uint64, tickIndex int32) (tickInfo types.TickInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTickInfoKey(poolId, tickIndex))
+	if bz == nil {
+		return tickInfo, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &tickInfo)
+	return tickInfo, true
+}
+
+// SetTickInfo stores a TickInfo, keyed by pool id and tick index.
+func (k Keeper) SetTickInfo(ctx sdk.Context, tickInfo types.TickInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&tickInfo)
+	store.Set(types.GetTickInfoKey(tickInfo.PoolId, tickInfo.TickIndex), bz)
+}
+
+// GetAllTickInfos returns every TickInfo for a pool, ordered by ascending tick index.
+func (k Keeper) GetAllTickInfos(ctx sdk.Context, poolId uint64) (tickInfos []types.TickInfo) {
+	store := ctx.KVStore(k.storeKey)
+	tickStore := prefix.NewStore(store, types.GetTickInfosPrefix(poolId))
+
+	iter := tickStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var tickInfo types.TickInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &tickInfo)
+		tickInfos = append(tickInfos, tickInfo)
+	}
+	return tickInfos
+}
+
+// addTickLiquidityNet adds delta to the TickInfo at tickIndex, creating it if it doesn't exist yet.
+func (k Keeper) addTickLiquidityNet(ctx sdk.Context, poolId uint64, tickIndex int32, delta sdk.Dec) {
+	tickInfo, found := k.GetTickInfo(ctx, poolId, tickIndex)
+	if !found {
+		tickInfo = types.TickInfo{PoolId: poolId, TickIndex: tickIndex, LiquidityNet: sdk.ZeroDec()}
+	}
+	tickInfo.LiquidityNet = tickInfo.LiquidityNet.Add(delta)
+	k.SetTickInfo(ctx, tickInfo)
+}
+
+// nextInitializedTick returns the nearest TickInfo on the given side of fromTick, if any. Crossing
+// ticks are discovered by a linear scan since a pool's position count, and therefore its distinct
+// tick boundaries, is expected to stay small.
+func (k Keeper) nextInitializedTick(ctx sdk.Context, poolId uint64, fromTick int32, ascending bool) (types.TickInfo, bool) {
+	var best types.TickInfo
+	found := false
+	for _, tickInfo := range k.GetAllTickInfos(ctx, poolId) {
+		if ascending {
+			if tickInfo.TickIndex > fromTick && (!found || tickInfo.TickIndex < best.TickIndex) {
+				best, found = tickInfo, true
+			}
+		} else {
+			if tickInfo.TickIndex < fromTick && (!found || tickInfo.TickIndex > best.TickIndex) {
+				best, found = tickInfo, true
+			}
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// EnqueueCreatePositionMsg queues a MsgCreatePosition for execution in the next EndBlocker. The
+// desired coins are escrowed into the pool's reserve account immediately, the same way the
+// constant-product pool's deposit path escrows on submission rather than at batch execution.
+func (k Keeper) EnqueueCreatePositionMsg(ctx sdk.Context, msg *types.MsgCreatePosition) error {
+	if _, found := k.GetConcentratedPool(ctx, msg.PoolId); !found {
+		return types.ErrPoolNotExists
+	}
+
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.ErrPoolNotExists
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, msg.Owner, pool.GetReserveAccount(), sdk.NewCoins(msg.DesiredCoinA, msg.DesiredCoinB)); err != nil {
+		return err
+	}
+
+	state := types.PositionMsgState{
+		MsgHeight: ctx.BlockHeight(),
+		MsgIndex:  k.getNextPositionMsgIndex(ctx, msg.PoolId),
+		PoolId:    msg.PoolId,
+		Owner:     msg.Owner.String(),
+		LowerTick: msg.LowerTick,
+		UpperTick: msg.UpperTick,
+		Liquidity: msg.DesiredCoinA.Amount.ToDec(),
+		CoinB:     msg.DesiredCoinB.Amount,
+	}
+	k.SetPositionMsgState(ctx, state)
+	return nil
+}
+
+// EnqueueWithdrawPositionMsg queues a MsgWithdrawPosition the same way.
+func (k Keeper) EnqueueWithdrawPositionMsg(ctx sdk.Context, msg *types.MsgWithdrawPosition) error {
+	if _, found := k.GetPosition(ctx, msg.PoolId, msg.Owner, msg.LowerTick, msg.UpperTick); !found {
+		return types.ErrPositionNotExists
+	}
+
+	state := types.PositionMsgState{
+		MsgHeight:  ctx.BlockHeight(),
+		MsgIndex:   k.getNextPositionMsgIndex(ctx, msg.PoolId),
+		IsWithdraw: true,
+		PoolId:     msg.PoolId,
+		Owner:      msg.Owner.String(),
+		LowerTick:  msg.LowerTick,
+		UpperTick:  msg.UpperTick,
+		Liquidity:  msg.Liquidity,
+	}
+	k.SetPositionMsgState(ctx, state)
+	return nil
+}
+
+// ExecutePositionMsgs applies every not-yet-executed PositionMsgState queued for poolId,
+// adjusting the pool's Liquidity and the owner's Position. A withdraw refunds its proportional
+// share of the escrowed coins back to the owner from the pool's reserve account; a create's
+// coins were already escrowed when the message was enqueued.
+func (k Keeper) ExecutePositionMsgs(ctx sdk.Context, poolId uint64) error {
+	pool, found := k.GetConcentratedPool(ctx, poolId)
+	if !found {
+		return nil
+	}
+
+	genericPool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return types.ErrPoolNotExists
+	}
+	reserveCoins := k.GetReserveCoins(ctx, genericPool)
+	denomA, denomB := reserveCoins[0].Denom, reserveCoins[1].Denom
+
+	states := k.GetAllNotExecutedPositionMsgStates(ctx, poolId)
+	for _, state := range states {
+		owner, err := sdk.AccAddressFromBech32(state.Owner)
+		if err != nil {
+			return err
+		}
+
+		position, found := k.GetPosition(ctx, poolId, owner, state.LowerTick, state.UpperTick)
+		if !found {
+			position = types.Position{
+				Owner:         state.Owner,
+				PoolId:        poolId,
+				LowerTick:     state.LowerTick,
+				UpperTick:     state.UpperTick,
+				Liquidity:     sdk.ZeroDec(),
+				EscrowedCoinB: sdk.ZeroInt(),
+			}
+		}
+
+		if state.IsWithdraw {
+			if state.Liquidity.GT(position.Liquidity) {
+				state.Executed = true
+				state.Succeeded = false
+				state.ToBeDeleted = true
+				k.SetPositionMsgState(ctx, state)
+				continue
+			}
+
+			refundA := state.Liquidity.TruncateInt()
+			refundB := position.EscrowedCoinB.ToDec().Mul(state.Liquidity).Quo(position.Liquidity).TruncateInt()
+			refundCoins := sdk.NewCoins(sdk.NewCoin(denomA, refundA), sdk.NewCoin(denomB, refundB))
+			if err := k.bankKeeper.SendCoins(ctx, genericPool.GetReserveAccount(), owner, refundCoins); err != nil {
+				return err
+			}
+
+			position.Liquidity = position.Liquidity.Sub(state.Liquidity)
+			position.EscrowedCoinB = position.EscrowedCoinB.Sub(refundB)
+			pool.Liquidity = pool.Liquidity.Sub(state.Liquidity)
+			k.addTickLiquidityNet(ctx, poolId, state.LowerTick, state.Liquidity.Neg())
+			k.addTickLiquidityNet(ctx, poolId, state.UpperTick, state.Liquidity)
+		} else {
+			position.Liquidity = position.Liquidity.Add(state.Liquidity)
+			position.EscrowedCoinB = position.EscrowedCoinB.Add(state.CoinB)
+			pool.Liquidity = pool.Liquidity.Add(state.Liquidity)
+			k.addTickLiquidityNet(ctx, poolId, state.LowerTick, state.Liquidity)
+			k.addTickLiquidityNet(ctx, poolId, state.UpperTick, state.Liquidity.Neg())
+		}
+
+		k.SetPosition(ctx, position)
+
+		state.Executed = true
+		state.Succeeded = true
+		state.ToBeDeleted = true
+		k.SetPositionMsgState(ctx, state)
+	}
+
+	k.SetConcentratedPool(ctx, pool)
+	return nil
+}
+
+func (k Keeper) getNextPositionMsgIndex(ctx sdk.Context, poolId uint64) uint64 {
+	return uint64(len(k.GetAllPositionMsgStates(ctx, poolId))) + 1
+}
+
+// SetPositionMsgState stores a queued PositionMsgState.
+func (k Keeper) SetPositionMsgState(ctx sdk.Context, state types.PositionMsgState) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&state)
+	store.Set(types.GetPositionMsgStateKey(state.PoolId, state.MsgIndex), bz)
+}
+
+// GetAllPositionMsgStates returns every PositionMsgState queued for poolId, executed or not.
+func (k Keeper) GetAllPositionMsgStates(ctx sdk.Context, poolId uint64) (states []types.PositionMsgState) {
+	store := ctx.KVStore(k.storeKey)
+	msgStore := prefix.NewStore(store, types.GetPositionMsgStatesPrefix(poolId))
+
+	iter := msgStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var state types.PositionMsgState
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &state)
+		states = append(states, state)
+	}
+	return states
+}
+
+// GetAllNotExecutedPositionMsgStates returns a pool's queued but not-yet-executed PositionMsgStates.
+func (k Keeper) GetAllNotExecutedPositionMsgStates(ctx sdk.Context, poolId uint64) (states []types.PositionMsgState) {
+	for _, state := range k.GetAllPositionMsgStates(ctx, poolId) {
+		if !state.Executed {
+			states = append(states, state)
+		}
+	}
+	return states
+}
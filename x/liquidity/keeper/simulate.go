@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// simulateSwap previews a hypothetical swap order against a pool's current batch without
+// mutating any state, by replaying the match pipeline on a cached context.
+func (k Keeper) simulateSwap(ctx sdk.Context, req types.QuerySimulateSwapRequest) (types.QuerySimulateSwapResponse, error) {
+	cacheCtx, _ := ctx.CacheContext()
+
+	pool, found := k.GetPool(cacheCtx, req.PoolId)
+	if !found {
+		return types.QuerySimulateSwapResponse{}, types.ErrPoolNotExists
+	}
+
+	batch, found := k.GetPoolBatch(cacheCtx, req.PoolId)
+	if !found {
+		return types.QuerySimulateSwapResponse{}, types.ErrPoolBatchNotExists
+	}
+
+	swapMsgStates := k.GetAllNotProcessedPoolBatchSwapMsgStates(cacheCtx, batch)
+
+	hypotheticalMsg := types.NewMsgSwapWithinBatch(
+		pool.GetReserveAccount(), req.PoolId, pool.TypeId, req.OfferCoin, req.DemandCoinDenom,
+		req.OrderPrice, pool.SwapFeeRate)
+
+	hypotheticalState := types.NewSwapMsgState(ctx.BlockHeight(), batch.MsgIndex+1, hypotheticalMsg)
+	swapMsgStates = append(swapMsgStates, hypotheticalState)
+
+	var X, Y sdk.Dec
+	var denomX, denomY string
+	if pool.TypeId == types.PoolTypeConcentrated {
+		_, X, Y, found = k.concentratedVirtualReserves(cacheCtx, pool.Id)
+		if !found {
+			return types.QuerySimulateSwapResponse{}, types.ErrPoolNotExists
+		}
+		reserveCoins := k.GetReserveCoins(cacheCtx, pool)
+		denomX, denomY = reserveCoins[0].Denom, reserveCoins[1].Denom
+	} else {
+		reserveCoins := k.GetReserveCoins(cacheCtx, pool)
+		X = reserveCoins[0].Amount.ToDec()
+		Y = reserveCoins[1].Amount.ToDec()
+		denomX, denomY = reserveCoins[0].Denom, reserveCoins[1].Denom
+	}
+
+	orderMap, XtoY, YtoX := types.MakeOrderMap(swapMsgStates, denomX, denomY, false)
+	orderBook := orderMap.SortOrderBook()
+	result := orderBook.Match(X, Y)
+
+	resp := types.QuerySimulateSwapResponse{
+		ExchangedDemandCoin: sdk.NewCoin(req.DemandCoinDenom, sdk.ZeroInt()),
+		RemainingOfferCoin:  req.OfferCoin,
+		EstimatedFee:        sdk.NewCoin(req.OfferCoin.Denom, sdk.ZeroInt()),
+		PostMatchPoolPrice:  X.Quo(Y),
+	}
+
+	if result.MatchType == types.NoMatch {
+		return resp, nil
+	}
+
+	var matchResultXtoY, matchResultYtoX []types.MatchResult
+	matchResultXtoY, _, _, _ = types.FindOrderMatch(types.DirectionXtoY, XtoY, result.EX, result.SwapPrice, ctx.BlockHeight())
+	matchResultYtoX, _, _, _ = types.FindOrderMatch(types.DirectionYtoX, YtoX, result.EY, result.SwapPrice, ctx.BlockHeight())
+
+	for _, match := range append(matchResultXtoY, matchResultYtoX...) {
+		if match.BatchMsg.MsgIndex != hypotheticalState.MsgIndex {
+			continue
+		}
+		resp.ExchangedDemandCoin = sdk.NewCoin(req.DemandCoinDenom, match.ExchangedDemandCoinAmt.TruncateInt())
+		resp.RemainingOfferCoin = types.CoinSafeSubAmount(req.OfferCoin, match.TransactedCoinAmt.TruncateInt())
+		resp.EstimatedFee = sdk.NewCoin(req.OfferCoin.Denom, match.OfferCoinFeeAmt.TruncateInt())
+		resp.PostMatchPoolPrice = result.SwapPrice
+		break
+	}
+
+	return resp, nil
+}
+
+// EstimatePoolPrice returns a pool's current spot price without replaying the batch, for light
+// clients that only need a quick preview.
+func (k Keeper) EstimatePoolPrice(ctx sdk.Context, poolId uint64) (sdk.Dec, error) {
+	pool, found := k.GetPool(ctx, poolId)
+	if !found {
+		return sdk.ZeroDec(), types.ErrPoolNotExists
+	}
+
+	if pool.TypeId == types.PoolTypeConcentrated {
+		_, X, Y, found := k.concentratedVirtualReserves(ctx, poolId)
+		if !found {
+			return sdk.ZeroDec(), types.ErrPoolNotExists
+		}
+		return X.Quo(Y), nil
+	}
+
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	X := reserveCoins[0].Amount.ToDec()
+	Y := reserveCoins[1].Amount.ToDec()
+
+	return X.Quo(Y), nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,7 +10,61 @@ import (
 )
 
 // Execute Swap of the pool batch, Collect swap messages in batch for transact the same price for each batch and run them on endblock.
-func (k Keeper) SwapExecution(ctx sdk.Context, liquidityPoolBatch types.PoolBatch) (uint64, error) {
+// If PanicOnInvariantFailure is false, an invariant violation aborts the batch gracefully
+// (see abortSwapBatch) instead of halting the chain.
+func (k Keeper) SwapExecution(ctx sdk.Context, liquidityPoolBatch types.PoolBatch) (count uint64, err error) {
+	if k.GetParams(ctx).PanicOnInvariantFailure {
+		return k.swapExecution(ctx, liquidityPoolBatch)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			count, err = k.abortSwapBatch(ctx, liquidityPoolBatch, fmt.Sprintf("%v", r))
+		}
+	}()
+
+	count, err = k.swapExecution(cacheCtx, liquidityPoolBatch)
+	if err != nil {
+		return k.abortSwapBatch(ctx, liquidityPoolBatch, err.Error())
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return count, nil
+}
+
+// abortSwapBatch refunds every message in the batch and emits an EventTypeBatchAborted event.
+func (k Keeper) abortSwapBatch(ctx sdk.Context, liquidityPoolBatch types.PoolBatch, reason string) (uint64, error) {
+	swapMsgStates := k.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, liquidityPoolBatch)
+
+	for _, sms := range swapMsgStates {
+		sms.Executed = true
+		sms.Succeeded = false
+		sms.ToBeDeleted = true
+	}
+	k.SetPoolBatchSwapMsgStatesByPointer(ctx, liquidityPoolBatch.PoolId, swapMsgStates)
+
+	for _, sms := range swapMsgStates {
+		if err := k.RefundSwapLiquidityPool(ctx, sms); err != nil {
+			return 0, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBatchAborted,
+			sdk.NewAttribute(types.AttributeKeyPoolId, fmt.Sprintf("%d", liquidityPoolBatch.PoolId)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	return uint64(len(swapMsgStates)), nil
+}
+
+// swapExecution runs the batch matching pipeline and panics on any invariant violation.
+func (k Keeper) swapExecution(ctx sdk.Context, liquidityPoolBatch types.PoolBatch) (uint64, error) {
 	// get all swap message batch states that are not executed, not succeeded, and not to be deleted.
 	swapMsgStates := k.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, liquidityPoolBatch)
 	if len(swapMsgStates) == 0 {
@@ -21,6 +76,11 @@ func (k Keeper) SwapExecution(ctx sdk.Context, liquidityPoolBatch types.PoolBatc
 		return 0, types.ErrPoolNotExists
 	}
 
+	// concentrated pools clear against tick liquidity instead of the p = X/Y match below.
+	if pool.TypeId == types.PoolTypeConcentrated {
+		return k.swapExecutionConcentrated(ctx, pool, liquidityPoolBatch, swapMsgStates)
+	}
+
 	// set executed states of all messages to true
 	for _, sms := range swapMsgStates {
 		sms.Executed = true
@@ -89,12 +149,9 @@ func (k Keeper) SwapExecution(ctx sdk.Context, liquidityPoolBatch types.PoolBatc
 	types.ValidateStateAndExpireOrders(YtoX, currentHeight, true)
 
 	// make index map for match result
-	matchResultMap := make(map[uint64]types.MatchResult)
-	for _, msg := range append(matchResultXtoY, matchResultYtoX...) {
-		if _, ok := matchResultMap[msg.OrderMsgIndex]; ok {
-			panic("duplicated match order")
-		}
-		matchResultMap[msg.OrderMsgIndex] = msg
+	matchResultMap, err := buildMatchResultMap(matchResultXtoY, matchResultYtoX)
+	if err != nil {
+		panic(err)
 	}
 
 	if invariantCheckFlag {
@@ -104,7 +161,7 @@ func (k Keeper) SwapExecution(ctx sdk.Context, liquidityPoolBatch types.PoolBatc
 
 	// execute transact, refund, expire, send coins with escrow, update state by TransactAndRefundSwapLiquidityPool
 	if err := k.TransactAndRefundSwapLiquidityPool(ctx, swapMsgStates, matchResultMap, pool, result); err != nil {
-		panic(err)
+		panic(fmt.Errorf("%w: %v", types.ErrInvariantBatchTransaction, err))
 	}
 
 	return executedMsgCount, nil
@@ -146,7 +203,7 @@ func (k Keeper) UpdateState(X, Y sdk.Dec, XtoY, YtoX []*types.SwapMsgState, matc
 				GT(match.BatchMsg.Msg.OfferCoin.Amount) ||
 				!match.BatchMsg.RemainingOfferCoin.Equal(sdk.NewCoin(match.BatchMsg.Msg.OfferCoin.Denom, sdk.ZeroInt())) ||
 				match.BatchMsg.ReservedOfferCoinFee.IsGTE(sdk.NewCoin(match.BatchMsg.ReservedOfferCoinFee.Denom, sdk.NewInt(2))) {
-				panic("remaining not matched 1")
+				panic(fmt.Errorf("%w: remaining not matched 1", types.ErrInvariantRemainingAmount))
 			} else {
 				match.BatchMsg.Succeeded = true
 				match.BatchMsg.ToBeDeleted = true
@@ -166,7 +223,7 @@ func (k Keeper) UpdateState(X, Y sdk.Dec, XtoY, YtoX []*types.SwapMsgState, matc
 				GT(match.BatchMsg.Msg.OfferCoin.Amount) ||
 				!match.BatchMsg.RemainingOfferCoin.Equal(sdk.NewCoin(match.BatchMsg.Msg.OfferCoin.Denom, sdk.ZeroInt())) ||
 				match.BatchMsg.ReservedOfferCoinFee.IsGTE(sdk.NewCoin(match.BatchMsg.ReservedOfferCoinFee.Denom, sdk.NewInt(2))) {
-				panic("remaining not matched 2")
+				panic(fmt.Errorf("%w: remaining not matched 2", types.ErrInvariantRemainingAmount))
 			} else {
 				match.BatchMsg.Succeeded = true
 				match.BatchMsg.ToBeDeleted = true
@@ -196,7 +253,7 @@ func (k Keeper) UpdateState(X, Y sdk.Dec, XtoY, YtoX []*types.SwapMsgState, matc
 				GT(match.BatchMsg.Msg.OfferCoin.Amount) ||
 				!match.BatchMsg.RemainingOfferCoin.Equal(sdk.NewCoin(match.BatchMsg.Msg.OfferCoin.Denom, sdk.ZeroInt())) ||
 				match.BatchMsg.ReservedOfferCoinFee.IsGTE(sdk.NewCoin(match.BatchMsg.ReservedOfferCoinFee.Denom, sdk.NewInt(2))) {
-				panic("remaining not matched 3")
+				panic(fmt.Errorf("%w: remaining not matched 3", types.ErrInvariantRemainingAmount))
 			} else {
 				match.BatchMsg.Succeeded = true
 				match.BatchMsg.ToBeDeleted = true
@@ -218,7 +275,7 @@ func (k Keeper) UpdateState(X, Y sdk.Dec, XtoY, YtoX []*types.SwapMsgState, matc
 				GT(match.BatchMsg.Msg.OfferCoin.Amount) ||
 				!match.BatchMsg.RemainingOfferCoin.Equal(sdk.NewCoin(match.BatchMsg.Msg.OfferCoin.Denom, sdk.ZeroInt())) ||
 				match.BatchMsg.ReservedOfferCoinFee.IsGTE(sdk.NewCoin(match.BatchMsg.ReservedOfferCoinFee.Denom, sdk.NewInt(2))) {
-				panic("remaining not matched 4")
+				panic(fmt.Errorf("%w: remaining not matched 4", types.ErrInvariantRemainingAmount))
 			} else {
 				match.BatchMsg.Succeeded = true
 				match.BatchMsg.ToBeDeleted = true
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// concentratedVirtualReserves returns the virtual (X, Y) reserves of the liquidity active at a
+// ConcentratedPool's current tick (L/sqrtP, L*sqrtP).
+func (k Keeper) concentratedVirtualReserves(ctx sdk.Context, poolId uint64) (concentratedPool types.ConcentratedPool, X, Y sdk.Dec, found bool) {
+	concentratedPool, found = k.GetConcentratedPool(ctx, poolId)
+	if !found {
+		return concentratedPool, sdk.ZeroDec(), sdk.ZeroDec(), false
+	}
+
+	X = concentratedPool.Liquidity.Quo(concentratedPool.CurrentSqrtPrice)
+	Y = concentratedPool.Liquidity.Mul(concentratedPool.CurrentSqrtPrice)
+	return concentratedPool, X, Y, true
+}
+
+// clipToTickBoundary bounds a match to the virtual reserves at boundarySqrtPrice instead of the
+// curve's unconstrained equilibrium, using the fact that X*Y = Liquidity^2 is invariant within a
+// single tick segment (Liquidity is constant there).
+func clipToTickBoundary(concentratedPool types.ConcentratedPool, X, Y, boundarySqrtPrice sdk.Dec) (EX, EY, swapPrice sdk.Dec) {
+	boundX := concentratedPool.Liquidity.Quo(boundarySqrtPrice)
+	boundY := concentratedPool.Liquidity.Mul(boundarySqrtPrice)
+	return boundX.Sub(X).Abs(), Y.Sub(boundY).Abs(), boundX.Quo(boundY)
+}
+
+// swapExecutionConcentrated clears a PoolTypeConcentrated pool's batch against the liquidity
+// active at its current tick. If the match would move the price past the nearest initialized
+// tick, the match is clipped to that tick boundary instead, and the pool's active Liquidity,
+// CurrentTick, and CurrentSqrtPrice are advanced across the crossing. Any order flow left over
+// past the boundary is not resolved within this call; it carries into the pool's next batch,
+// the same way the constant-product path carries a fractional match into the next block rather
+// than looping within one.
+func (k Keeper) swapExecutionConcentrated(ctx sdk.Context, pool types.LiquidityPool, liquidityPoolBatch types.PoolBatch, swapMsgStates []*types.SwapMsgState) (uint64, error) {
+	for _, sms := range swapMsgStates {
+		sms.Executed = true
+	}
+	k.SetPoolBatchSwapMsgStatesByPointer(ctx, pool.Id, swapMsgStates)
+
+	currentHeight := ctx.BlockHeight()
+	types.ValidateStateAndExpireOrders(swapMsgStates, currentHeight, false)
+
+	concentratedPool, X, Y, found := k.concentratedVirtualReserves(ctx, pool.Id)
+	if !found {
+		return 0, types.ErrPoolNotExists
+	}
+
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	denomX := reserveCoins[0].Denom
+	denomY := reserveCoins[1].Denom
+
+	orderMap, XtoY, YtoX := types.MakeOrderMap(swapMsgStates, denomX, denomY, false)
+	orderBook := orderMap.SortOrderBook()
+	result := orderBook.Match(X, Y)
+
+	executedMsgCount := uint64(len(swapMsgStates))
+	if result.MatchType == types.NoMatch {
+		return executedMsgCount, nil
+	}
+
+	// SwapPrice is X/Y, while sqrt prices follow the (L/sqrtP, L*sqrtP) convention, i.e. sqrt(Y/X).
+	prospectiveSqrtPrice, err := sdk.OneDec().Quo(result.SwapPrice).ApproxSqrt()
+	if err != nil {
+		panic(fmt.Errorf("%w: %v", types.ErrInvariantBatchTransaction, err))
+	}
+	ascending := prospectiveSqrtPrice.GT(concentratedPool.CurrentSqrtPrice)
+
+	crossedTick, crossingFound := k.nextInitializedTick(ctx, pool.Id, concentratedPool.CurrentTick, ascending)
+
+	EX, EY, swapPrice := result.EX, result.EY, result.SwapPrice
+	crossed := false
+	var boundarySqrtPrice sdk.Dec
+	if crossingFound {
+		boundarySqrtPrice = tickToSqrtPrice(crossedTick.TickIndex)
+		if (ascending && prospectiveSqrtPrice.GT(boundarySqrtPrice)) || (!ascending && prospectiveSqrtPrice.LT(boundarySqrtPrice)) {
+			EX, EY, swapPrice = clipToTickBoundary(concentratedPool, X, Y, boundarySqrtPrice)
+			crossed = true
+		}
+	}
+
+	matchResultXtoY, _, _, _ := types.FindOrderMatch(types.DirectionXtoY, XtoY, EX, swapPrice, currentHeight)
+	matchResultYtoX, _, _, _ := types.FindOrderMatch(types.DirectionYtoX, YtoX, EY, swapPrice, currentHeight)
+
+	matchResultMap, err := buildMatchResultMap(matchResultXtoY, matchResultYtoX)
+	if err != nil {
+		panic(err)
+	}
+
+	clippedResult := result
+	clippedResult.EX, clippedResult.EY, clippedResult.SwapPrice = EX, EY, swapPrice
+	if err := k.TransactAndRefundSwapLiquidityPool(ctx, swapMsgStates, matchResultMap, pool, clippedResult); err != nil {
+		panic(fmt.Errorf("%w: %v", types.ErrInvariantBatchTransaction, err))
+	}
+
+	if crossed {
+		if ascending {
+			concentratedPool.Liquidity = concentratedPool.Liquidity.Add(crossedTick.LiquidityNet)
+		} else {
+			concentratedPool.Liquidity = concentratedPool.Liquidity.Sub(crossedTick.LiquidityNet)
+		}
+		concentratedPool.CurrentTick = crossedTick.TickIndex
+		concentratedPool.CurrentSqrtPrice = boundarySqrtPrice
+	} else {
+		sqrtPrice, err := sdk.OneDec().Quo(swapPrice).ApproxSqrt()
+		if err != nil {
+			panic(fmt.Errorf("%w: %v", types.ErrInvariantBatchTransaction, err))
+		}
+		concentratedPool.CurrentSqrtPrice = sqrtPrice
+	}
+	k.SetConcentratedPool(ctx, concentratedPool)
+
+	return executedMsgCount, nil
+}
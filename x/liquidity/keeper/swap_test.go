@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// TestUpdateStateManufacturedDecimalDrift manufactures off-by-one decimal drift on both the XtoY
+// and YtoX sides of the same batch and asserts UpdateState resolves both without panicking,
+// leaving every order fully matched rather than stuck -- i.e. the chain keeps advancing instead
+// of halting on an off-by-one truncation.
+func TestUpdateStateManufacturedDecimalDrift(t *testing.T) {
+	var k keeper.Keeper
+
+	xToYMsg := newDriftSwapMsgState(t, 1, "denomX", "denomY", 1000001)
+	yToXMsg := newDriftSwapMsgState(t, 2, "denomY", "denomX", 2000001)
+
+	matchXtoY := types.MatchResult{
+		OrderMsgIndex:          1,
+		BatchMsg:               xToYMsg,
+		TransactedCoinAmt:      sdk.NewDec(1000000),
+		ExchangedDemandCoinAmt: sdk.NewDec(500000),
+		OfferCoinFeeAmt:        sdk.ZeroDec(),
+	}
+	matchYtoX := types.MatchResult{
+		OrderMsgIndex:          2,
+		BatchMsg:               yToXMsg,
+		TransactedCoinAmt:      sdk.NewDec(2000000),
+		ExchangedDemandCoinAmt: sdk.NewDec(1000000),
+		OfferCoinFeeAmt:        sdk.ZeroDec(),
+	}
+
+	X := sdk.NewDec(100000000)
+	Y := sdk.NewDec(300000000)
+
+	require.NotPanics(t, func() {
+		_, _, _, _, _, _, _, _, decimalErrorX, decimalErrorY := k.UpdateState(
+			X, Y,
+			[]*types.SwapMsgState{xToYMsg}, []*types.SwapMsgState{yToXMsg},
+			[]types.MatchResult{matchXtoY}, []types.MatchResult{matchYtoX},
+		)
+
+		require.True(t, decimalErrorX.Equal(sdk.OneDec()), "expected XtoY decimal drift of 1, got %s", decimalErrorX)
+		require.True(t, decimalErrorY.Equal(sdk.OneDec()), "expected YtoX decimal drift of 1, got %s", decimalErrorY)
+	})
+
+	require.True(t, xToYMsg.Succeeded)
+	require.True(t, xToYMsg.ToBeDeleted)
+	require.True(t, yToXMsg.Succeeded)
+	require.True(t, yToXMsg.ToBeDeleted)
+}
+
+// newDriftSwapMsgState builds a SwapMsgState whose offer amount is exactly one unit above what
+// newDriftSwapMsgState's caller will later pass as TransactedCoinAmt, manufacturing the off-by-one
+// truncation UpdateState's decimal-error branch exists to absorb.
+func newDriftSwapMsgState(t *testing.T, msgIndex uint64, offerDenom, demandDenom string, offerAmt int64) *types.SwapMsgState {
+	t.Helper()
+
+	offerCoin := sdk.NewCoin(offerDenom, sdk.NewInt(offerAmt))
+	msg := types.NewMsgSwapWithinBatch(sdk.AccAddress{}, 1, 1, offerCoin, demandDenom, sdk.OneDec(), sdk.ZeroDec())
+
+	msgState := types.NewSwapMsgState(0, msgIndex, msg)
+	msgState.RemainingOfferCoin = offerCoin
+	msgState.ReservedOfferCoinFee = sdk.NewCoin(offerDenom, sdk.ZeroInt())
+	msgState.Executed = true
+
+	return msgState
+}
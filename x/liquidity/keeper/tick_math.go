@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// sqrtPriceRatio is sqrt(1.0001), the per-tick price step concentrated liquidity pools use: the
+// sqrt price of adjacent ticks differs by this factor (truncated to 18 decimal places).
+var sqrtPriceRatio = sdk.MustNewDecFromStr("1.000049998750062496")
+
+// tickToSqrtPrice converts a tick index to its sqrt price, sqrtPriceRatio^tick, via exponentiation
+// by squaring so an arbitrarily large |tick| costs O(log tick) multiplications.
+func tickToSqrtPrice(tick int32) sdk.Dec {
+	exp := tick
+	neg := exp < 0
+	if neg {
+		exp = -exp
+	}
+
+	result := sdk.OneDec()
+	base := sqrtPriceRatio
+	for n := uint32(exp); n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = result.Mul(base)
+		}
+		base = base.Mul(base)
+	}
+
+	if neg {
+		return sdk.OneDec().Quo(result)
+	}
+	return result
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the concentrated-liquidity position messages for amino encoding.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreatePosition{}, "liquidity/MsgCreatePosition", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "liquidity/MsgWithdrawPosition", nil)
+}
+
+// ModuleCdc is used by MsgCreatePosition/MsgWithdrawPosition to produce canonical sign bytes.
+var ModuleCdc = codec.NewLegacyAmino()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Invariant violation errors raised by the batch matching engine.
+var (
+	ErrInvariantDuplicateMatchOrder = sdkerrors.Register(ModuleName, 71, "duplicated match order")
+	ErrInvariantRemainingAmount     = sdkerrors.Register(ModuleName, 72, "remaining offer coin not fully matched")
+	ErrInvariantBatchTransaction    = sdkerrors.Register(ModuleName, 73, "failed to transact and refund swap liquidity pool")
+)
+
+// ErrPositionNotExists is returned when an owner has no Position over the requested tick range,
+// as distinct from the pool itself not existing (ErrPoolNotExists).
+var ErrPositionNotExists = sdkerrors.Register(ModuleName, 74, "position does not exist")
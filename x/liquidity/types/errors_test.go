@@ -0,0 +1,25 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+// TestInvariantErrorsDistinct guards against the invariant sentinel errors collapsing to the same message.
+func TestInvariantErrorsDistinct(t *testing.T) {
+	errs := []error{
+		types.ErrInvariantDuplicateMatchOrder,
+		types.ErrInvariantRemainingAmount,
+		types.ErrInvariantBatchTransaction,
+	}
+
+	seen := make(map[string]bool)
+	for _, err := range errs {
+		require.NotEmpty(t, err.Error())
+		require.False(t, seen[err.Error()], "duplicate invariant error message: %s", err.Error())
+		seen[err.Error()] = true
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+// Event types and attribute keys emitted when a batch is aborted instead of halting the chain.
+// Emitted by the keeper's abortSwapBatch, which SwapExecution calls.
+const (
+	EventTypeBatchAborted = "batch_aborted"
+
+	AttributeKeyPoolId = "pool_id"
+	AttributeKeyReason = "reason"
+)
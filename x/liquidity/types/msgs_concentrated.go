@@ -0,0 +1,106 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreatePosition   = "create_position"
+	TypeMsgWithdrawPosition = "withdraw_position"
+)
+
+var (
+	_ sdk.Msg = &MsgCreatePosition{}
+	_ sdk.Msg = &MsgWithdrawPosition{}
+)
+
+// MsgCreatePosition opens a concentrated liquidity position in [LowerTick, UpperTick) of a
+// PoolTypeConcentrated pool, queued for EndBlocker like a MsgSwapWithinBatch.
+type MsgCreatePosition struct {
+	PoolId       uint64         `json:"pool_id"`
+	Owner        sdk.AccAddress `json:"owner"`
+	LowerTick    int32          `json:"lower_tick"`
+	UpperTick    int32          `json:"upper_tick"`
+	DesiredCoinA sdk.Coin       `json:"desired_coin_a"`
+	DesiredCoinB sdk.Coin       `json:"desired_coin_b"`
+}
+
+func NewMsgCreatePosition(owner sdk.AccAddress, poolId uint64, lowerTick, upperTick int32, coinA, coinB sdk.Coin) *MsgCreatePosition {
+	return &MsgCreatePosition{
+		PoolId:       poolId,
+		Owner:        owner,
+		LowerTick:    lowerTick,
+		UpperTick:    upperTick,
+		DesiredCoinA: coinA,
+		DesiredCoinB: coinB,
+	}
+}
+
+func (msg MsgCreatePosition) Route() string { return RouterKey }
+func (msg MsgCreatePosition) Type() string  { return TypeMsgCreatePosition }
+
+func (msg MsgCreatePosition) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	if msg.LowerTick >= msg.UpperTick {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "lower tick must be less than upper tick")
+	}
+	if !msg.DesiredCoinA.IsValid() || !msg.DesiredCoinB.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "desired coins are invalid")
+	}
+	return nil
+}
+
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgWithdrawPosition closes all or part of the liquidity in an existing Position, routed
+// through the batch the same way MsgCreatePosition and MsgSwapWithinBatch are.
+type MsgWithdrawPosition struct {
+	PoolId    uint64         `json:"pool_id"`
+	Owner     sdk.AccAddress `json:"owner"`
+	LowerTick int32          `json:"lower_tick"`
+	UpperTick int32          `json:"upper_tick"`
+	Liquidity sdk.Dec        `json:"liquidity"`
+}
+
+func NewMsgWithdrawPosition(owner sdk.AccAddress, poolId uint64, lowerTick, upperTick int32, liquidity sdk.Dec) *MsgWithdrawPosition {
+	return &MsgWithdrawPosition{
+		PoolId:    poolId,
+		Owner:     owner,
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+		Liquidity: liquidity,
+	}
+}
+
+func (msg MsgWithdrawPosition) Route() string { return RouterKey }
+func (msg MsgWithdrawPosition) Type() string  { return TypeMsgWithdrawPosition }
+
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	if msg.LowerTick >= msg.UpperTick {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "lower tick must be less than upper tick")
+	}
+	if !msg.Liquidity.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "liquidity must be positive")
+	}
+	return nil
+}
+
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values.
+const (
+	DefaultPanicOnInvariantFailure = true
+)
+
+// Parameter store keys.
+var (
+	KeyPanicOnInvariantFailure = []byte("PanicOnInvariantFailure")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// Params defines the parameters of the liquidity module.
+type Params struct {
+	// PanicOnInvariantFailure halts the chain when the batch matching engine detects an
+	// invariant violation (the pre-existing behavior). When false, the offending batch is
+	// aborted gracefully instead; see Keeper.SwapExecution.
+	PanicOnInvariantFailure bool `json:"panic_on_invariant_failure" yaml:"panic_on_invariant_failure"`
+}
+
+// NewParams creates a new Params instance.
+func NewParams(panicOnInvariantFailure bool) Params {
+	return Params{
+		PanicOnInvariantFailure: panicOnInvariantFailure,
+	}
+}
+
+// DefaultParams returns the default liquidity module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultPanicOnInvariantFailure)
+}
+
+// ParamKeyTable returns the param key table for the liquidity module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements paramtypes.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPanicOnInvariantFailure, &p.PanicOnInvariantFailure, validatePanicOnInvariantFailure),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	return validatePanicOnInvariantFailure(p.PanicOnInvariantFailure)
+}
+
+func validatePanicOnInvariantFailure(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
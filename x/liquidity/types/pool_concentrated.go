@@ -0,0 +1,115 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolTypeConcentrated is the second pool type (PoolType 1 is the constant-product pool); its
+// reserves are segmented into price ticks instead of a single (X, Y) pair.
+const PoolTypeConcentrated = 2
+
+// ConcentratedPool holds the tick-segmented reserve state of a PoolTypeConcentrated pool.
+type ConcentratedPool struct {
+	Id               uint64  `json:"id"`
+	TickSpacing      uint32  `json:"tick_spacing"`
+	CurrentTick      int32   `json:"current_tick"`
+	CurrentSqrtPrice sdk.Dec `json:"current_sqrt_price"`
+	Liquidity        sdk.Dec `json:"liquidity"`
+}
+
+// Position is one liquidity provider's concentrated range, [LowerTick, UpperTick). EscrowedCoinB
+// tracks the CoinB currently held in the pool's reserve account backing Liquidity, so a partial
+// withdraw can refund its proportional share; Liquidity itself is denominated in CoinA.
+type Position struct {
+	Owner         string  `json:"owner"`
+	PoolId        uint64  `json:"pool_id"`
+	LowerTick     int32   `json:"lower_tick"`
+	UpperTick     int32   `json:"upper_tick"`
+	Liquidity     sdk.Dec `json:"liquidity"`
+	EscrowedCoinB sdk.Int `json:"escrowed_coin_b"`
+}
+
+// PositionMsgState tracks a queued MsgCreatePosition/MsgWithdrawPosition, mirroring SwapMsgState.
+// For a create, Liquidity and CoinB carry the already-escrowed DesiredCoinA/DesiredCoinB amounts;
+// for a withdraw, Liquidity carries the amount of liquidity being withdrawn and CoinB is unused.
+type PositionMsgState struct {
+	MsgHeight   int64   `json:"msg_height"`
+	MsgIndex    uint64  `json:"msg_index"`
+	Executed    bool    `json:"executed"`
+	Succeeded   bool    `json:"succeeded"`
+	ToBeDeleted bool    `json:"to_be_deleted"`
+	IsWithdraw  bool    `json:"is_withdraw"`
+	PoolId      uint64  `json:"pool_id"`
+	Owner       string  `json:"owner"`
+	LowerTick   int32   `json:"lower_tick"`
+	UpperTick   int32   `json:"upper_tick"`
+	Liquidity   sdk.Dec `json:"liquidity"`
+	CoinB       sdk.Int `json:"coin_b"`
+}
+
+// TickInfo tracks the net change in a ConcentratedPool's active Liquidity applied when CurrentTick
+// crosses TickIndex: each position adds its liquidity to LiquidityNet at its LowerTick and
+// subtracts it at its UpperTick; crossing the tick moving up adds LiquidityNet to the active
+// Liquidity and crossing it moving down subtracts it, the standard per-tick bookkeeping
+// concentrated liquidity pools use so a position only counts while the price sits inside
+// [LowerTick, UpperTick).
+type TickInfo struct {
+	PoolId       uint64  `json:"pool_id"`
+	TickIndex    int32   `json:"tick_index"`
+	LiquidityNet sdk.Dec `json:"liquidity_net"`
+}
+
+// KV store key prefixes for ConcentratedPool, Position, PositionMsgState, and TickInfo.
+var (
+	ConcentratedPoolKeyPrefix = []byte{0x40}
+	PositionKeyPrefix         = []byte{0x41}
+	PositionMsgStateKeyPrefix = []byte{0x42}
+	TickInfoKeyPrefix         = []byte{0x43}
+)
+
+// GetConcentratedPoolKey returns the KV store key for a ConcentratedPool by pool id.
+func GetConcentratedPoolKey(poolId uint64) []byte {
+	return append(ConcentratedPoolKeyPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetPositionKey returns the KV store key for a Position, scoped by pool id, owner, and tick
+// range so one owner can hold a separate Position per [lowerTick, upperTick) range in a pool.
+func GetPositionKey(poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int32) []byte {
+	key := append(append(PositionKeyPrefix, sdk.Uint64ToBigEndian(poolId)...), owner.Bytes()...)
+	key = append(key, encodeTickIndex(lowerTick)...)
+	key = append(key, encodeTickIndex(upperTick)...)
+	return key
+}
+
+// encodeTickIndex big-endian encodes a signed tick index for use as a KV store key component.
+func encodeTickIndex(tick int32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(tick))
+	return b
+}
+
+// GetPositionMsgStatesPrefix returns the KV store key prefix for a pool's queued PositionMsgStates.
+func GetPositionMsgStatesPrefix(poolId uint64) []byte {
+	return append(PositionMsgStateKeyPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetPositionMsgStateKey returns the KV store key for a single queued PositionMsgState.
+func GetPositionMsgStateKey(poolId, msgIndex uint64) []byte {
+	return append(GetPositionMsgStatesPrefix(poolId), sdk.Uint64ToBigEndian(msgIndex)...)
+}
+
+// GetTickInfosPrefix returns the KV store key prefix for a pool's TickInfo entries. The tick
+// index is encoded sign-bit-flipped so lexicographic byte order matches numeric tick order,
+// letting GetAllTickInfos iterate ticks low-to-high.
+func GetTickInfosPrefix(poolId uint64) []byte {
+	return append(TickInfoKeyPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetTickInfoKey returns the KV store key for a single TickInfo.
+func GetTickInfoKey(poolId uint64, tickIndex int32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(tickIndex)^0x80000000)
+	return append(GetTickInfosPrefix(poolId), b...)
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QuerySimulateSwapRequest is the request type for the Query/SimulateSwap RPC method.
+type QuerySimulateSwapRequest struct {
+	PoolId          uint64   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	OfferCoin       sdk.Coin `protobuf:"bytes,2,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin"`
+	DemandCoinDenom string   `protobuf:"bytes,3,opt,name=demand_coin_denom,json=demandCoinDenom,proto3" json:"demand_coin_denom,omitempty"`
+	OrderPrice      sdk.Dec  `protobuf:"bytes,4,opt,name=order_price,json=orderPrice,proto3" json:"order_price"`
+}
+
+// QuerySimulateSwapResponse is the response type for the Query/SimulateSwap RPC method.
+type QuerySimulateSwapResponse struct {
+	ExchangedDemandCoin sdk.Coin `protobuf:"bytes,1,opt,name=exchanged_demand_coin,json=exchangedDemandCoin,proto3" json:"exchanged_demand_coin"`
+	RemainingOfferCoin  sdk.Coin `protobuf:"bytes,2,opt,name=remaining_offer_coin,json=remainingOfferCoin,proto3" json:"remaining_offer_coin"`
+	EstimatedFee        sdk.Coin `protobuf:"bytes,3,opt,name=estimated_fee,json=estimatedFee,proto3" json:"estimated_fee"`
+	PostMatchPoolPrice  sdk.Dec  `protobuf:"bytes,4,opt,name=post_match_pool_price,json=postMatchPoolPrice,proto3" json:"post_match_pool_price"`
+}
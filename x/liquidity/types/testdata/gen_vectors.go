@@ -0,0 +1,205 @@
+// Command gen_vectors regenerates the `expected` section of every vector under testdata/vectors
+// against the current matching engine. Run via `make gen-vectors` after an intentional change
+// to the algorithm -- never to silence a failing TestConformance.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/liquidity/x/liquidity/keeper"
+	"github.com/tendermint/liquidity/x/liquidity/types"
+)
+
+const vectorsDir = "vectors"
+
+type vectorOrder struct {
+	Direction         string `json:"direction"`
+	Price             string `json:"price"`
+	OfferAmt          string `json:"offer_amt"`
+	RemainingOfferAmt string `json:"remaining_offer_amt"`
+	Fee               string `json:"fee"`
+}
+
+type vectorMessage struct {
+	TransactedCoinAmt      string `json:"transacted_coin_amt"`
+	ExchangedDemandCoinAmt string `json:"exchanged_demand_coin_amt"`
+	Succeeded              bool   `json:"succeeded"`
+	ToBeDeleted            bool   `json:"to_be_deleted"`
+}
+
+type vectorExpected struct {
+	SwapPrice     string          `json:"swap_price"`
+	MatchType     int             `json:"match_type"`
+	EX            string          `json:"ex"`
+	EY            string          `json:"ey"`
+	PoolXDelta    string          `json:"pool_x_delta"`
+	PoolYDelta    string          `json:"pool_y_delta"`
+	DecimalErrorX string          `json:"decimal_error_x"`
+	DecimalErrorY string          `json:"decimal_error_y"`
+	Messages      []vectorMessage `json:"messages"`
+}
+
+type swapBatchVector struct {
+	Name     string         `json:"name"`
+	ReserveX string         `json:"reserve_x"`
+	ReserveY string         `json:"reserve_y"`
+	DenomX   string         `json:"denom_x"`
+	DenomY   string         `json:"denom_y"`
+	Orders   []vectorOrder  `json:"orders"`
+	Expected vectorExpected `json:"expected"`
+}
+
+func main() {
+	entries, err := ioutil.ReadDir(vectorsDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var k keeper.Keeper
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		path := filepath.Join(vectorsDir, entry.Name())
+		bz, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var vector swapBatchVector
+		if err := json.Unmarshal(bz, &vector); err != nil {
+			log.Fatal(err)
+		}
+
+		vector.Expected = regenerate(k, vector)
+
+		out, err := json.MarshalIndent(vector, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, append(out, '\n'), 0o644); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("regenerated %s\n", path)
+	}
+}
+
+func regenerate(k keeper.Keeper, vector swapBatchVector) vectorExpected {
+	X, err := sdk.NewDecFromStr(vector.ReserveX)
+	if err != nil {
+		log.Fatal(err)
+	}
+	Y, err := sdk.NewDecFromStr(vector.ReserveY)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	swapMsgStates := make([]*types.SwapMsgState, len(vector.Orders))
+	for i, o := range vector.Orders {
+		swapMsgStates[i] = newSwapMsgState(uint64(i+1), o, vector.DenomX, vector.DenomY)
+	}
+
+	orderMap, XtoY, YtoX := types.MakeOrderMap(swapMsgStates, vector.DenomX, vector.DenomY, false)
+	orderBook := orderMap.SortOrderBook()
+	result := orderBook.Match(X, Y)
+
+	expected := vectorExpected{
+		SwapPrice: "0",
+		MatchType: int(result.MatchType),
+		EX:        "0",
+		EY:        "0",
+		Messages:  make([]vectorMessage, len(vector.Orders)),
+	}
+
+	if result.MatchType == types.NoMatch {
+		for i, msg := range swapMsgStates {
+			expected.Messages[i] = vectorMessage{
+				TransactedCoinAmt:      "0",
+				ExchangedDemandCoinAmt: "0",
+				Succeeded:              msg.Succeeded,
+				ToBeDeleted:            msg.ToBeDeleted,
+			}
+		}
+		expected.PoolXDelta, expected.PoolYDelta = "0", "0"
+		expected.DecimalErrorX, expected.DecimalErrorY = "0", "0"
+		return expected
+	}
+
+	expected.SwapPrice = result.SwapPrice.String()
+	expected.EX = result.EX.String()
+	expected.EY = result.EY.String()
+
+	matchResultXtoY, _, _, _ := types.FindOrderMatch(types.DirectionXtoY, XtoY, result.EX, result.SwapPrice, 0)
+	matchResultYtoX, _, _, _ := types.FindOrderMatch(types.DirectionYtoX, YtoX, result.EY, result.SwapPrice, 0)
+
+	_, _, _, _, poolXdelta, poolYdelta, _, _, decimalErrorX, decimalErrorY := k.UpdateState(X, Y, XtoY, YtoX, matchResultXtoY, matchResultYtoX)
+
+	expected.PoolXDelta = poolXdelta.String()
+	expected.PoolYDelta = poolYdelta.String()
+	expected.DecimalErrorX = decimalErrorX.String()
+	expected.DecimalErrorY = decimalErrorY.String()
+
+	matchResultMap := make(map[uint64]types.MatchResult)
+	for _, m := range append(matchResultXtoY, matchResultYtoX...) {
+		matchResultMap[m.BatchMsg.MsgIndex] = m
+	}
+
+	for i, msg := range swapMsgStates {
+		m, matched := matchResultMap[msg.MsgIndex]
+		vm := vectorMessage{
+			TransactedCoinAmt:      "0",
+			ExchangedDemandCoinAmt: "0",
+			Succeeded:              msg.Succeeded,
+			ToBeDeleted:            msg.ToBeDeleted,
+		}
+		if matched {
+			vm.TransactedCoinAmt = m.TransactedCoinAmt.String()
+			vm.ExchangedDemandCoinAmt = m.ExchangedDemandCoinAmt.String()
+		}
+		expected.Messages[i] = vm
+	}
+
+	return expected
+}
+
+func newSwapMsgState(msgIndex uint64, o vectorOrder, denomX, denomY string) *types.SwapMsgState {
+	price, err := sdk.NewDecFromStr(o.Price)
+	if err != nil {
+		log.Fatal(err)
+	}
+	offerAmt, ok := sdk.NewIntFromString(o.OfferAmt)
+	if !ok {
+		log.Fatalf("invalid offer_amt %q", o.OfferAmt)
+	}
+	remainingAmt, ok := sdk.NewIntFromString(o.RemainingOfferAmt)
+	if !ok {
+		log.Fatalf("invalid remaining_offer_amt %q", o.RemainingOfferAmt)
+	}
+	fee, err := sdk.NewDecFromStr(o.Fee)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	offerDenom, demandDenom := denomX, denomY
+	if o.Direction == "YtoX" {
+		offerDenom, demandDenom = denomY, denomX
+	}
+
+	offerCoin := sdk.NewCoin(offerDenom, offerAmt)
+	msg := types.NewMsgSwapWithinBatch(sdk.AccAddress{}, 1, 1, offerCoin, demandDenom, price, sdk.NewDecFromInt(fee.TruncateInt()))
+
+	msgState := types.NewSwapMsgState(0, msgIndex, msg)
+	msgState.RemainingOfferCoin = sdk.NewCoin(offerDenom, remainingAmt)
+	msgState.ReservedOfferCoinFee = sdk.NewCoin(offerDenom, fee.TruncateInt())
+	msgState.Executed = true
+
+	return msgState
+}